@@ -0,0 +1,34 @@
+package services
+
+import (
+	"github.com/guregu/null"
+
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+// FluxMonitor is a wrapper for `models.FluxMonitorSpec`, mirroring
+// EthRequestEvent for OCR jobs.
+type FluxMonitor struct {
+	Type          string      `toml:"type"`
+	SchemaVersion uint32      `toml:"schemaVersion"`
+	Name          null.String `toml:"name"`
+
+	models.FluxMonitorSpec
+
+	// The `jobID` field exists to cache the ID from the jobs table that
+	// joins to the flux_monitor_specs table.
+	jobID int32
+
+	// The `Pipeline` field holds the observationSource DAG. It is filled in
+	// when the TOML is unmarshaled, and by FluxMonitorFromJobSpecV2 when a
+	// stored job is rebuilt for export.
+	Pipeline pipeline.TaskDAG `toml:"observationSource"`
+}
+
+// JobType returns the job.Type this spec should be run as, fulfilling the
+// job.Spec interface.
+func (fm FluxMonitor) JobType() job.Type {
+	return job.Type(fm.Type)
+}
@@ -0,0 +1,29 @@
+package job
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/store"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+// ValidateKeyStoreDependencies confirms that the OCR key bundle, P2P peer
+// ID, and transmitter address referenced by spec are all present in the
+// node's keystore. AddJobV2 performs this same check as part of starting
+// the job, surfacing ErrNoSuchKeyBundle / ErrNoSuchPeerID /
+// ErrNoSuchTransmitterAddress; it is split out here so dry-run validation
+// can reuse it before anything is persisted or started.
+func ValidateKeyStoreDependencies(s *store.Store, spec models.OffchainReportingOracleSpec) error {
+	if _, err := s.OCRKeyStore.DecryptedKeyBundle(spec.EncryptedOCRKeyBundleID); err != nil {
+		return errors.Wrapf(ErrNoSuchKeyBundle, "%s", spec.EncryptedOCRKeyBundleID)
+	}
+	if spec.P2PPeerID.Valid {
+		if _, err := s.OCRKeyStore.FindP2PKeyByID(spec.P2PPeerID.String); err != nil {
+			return errors.Wrapf(ErrNoSuchPeerID, "%s", spec.P2PPeerID.String)
+		}
+	}
+	if _, err := s.KeyStore.FindKeyByAddress(spec.TransmitterAddress); err != nil {
+		return errors.Wrapf(ErrNoSuchTransmitterAddress, "%s", spec.TransmitterAddress)
+	}
+	return nil
+}
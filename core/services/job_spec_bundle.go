@@ -0,0 +1,130 @@
+package services
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/BurntSushi/toml"
+	"github.com/guregu/null"
+	"github.com/pkg/errors"
+	"gonum.org/v1/gonum/graph/encoding/dot"
+
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+// jobTypeHeader is used to sniff the `type` field out of a job spec TOML
+// document before deciding which ValidatedXSpecToml to hand it to.
+type jobTypeHeader struct {
+	Type string `toml:"type"`
+}
+
+// SniffJobSpecType parses just the `type` field out of a job spec TOML
+// document, without validating the rest of it. Import uses this to route
+// each spec in a JobSpecBundle to the right validator.
+func SniffJobSpecType(tomlString string) (string, error) {
+	var header jobTypeHeader
+	if err := toml.Unmarshal([]byte(tomlString), &header); err != nil {
+		return "", errors.Wrap(err, "toml error on load")
+	}
+	if header.Type == "" {
+		return "", errors.New("spec is missing its type")
+	}
+	return header.Type, nil
+}
+
+// marshalObservationSource renders dag back into the DOT source a job spec
+// TOML's observationSource key expects, the inverse of dot.Unmarshal-ing it
+// into a pipeline.TaskDAG during validation.
+func marshalObservationSource(dag pipeline.TaskDAG) (string, error) {
+	dotBytes, err := dot.Marshal(&dag, "", "", "    ")
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal observationSource to DOT")
+	}
+	return string(dotBytes), nil
+}
+
+// ocrJobSpecTomlHeader is everything in EthRequestEvent except Pipeline,
+// which is encoded separately: Pipeline is a pipeline.TaskDAG, not DOT
+// source, so handing it to the TOML encoder directly would serialize its
+// internal graph representation instead of the text Import expects back
+// under observationSource.
+type ocrJobSpecTomlHeader struct {
+	Type            string          `toml:"type"`
+	SchemaVersion   uint32          `toml:"schemaVersion"`
+	Name            null.String     `toml:"name"`
+	MaxTaskDuration models.Interval `toml:"maxTaskDuration"`
+
+	models.OffchainReportingOracleSpec
+}
+
+// MarshalOCRJobSpecToml reconstructs the TOML document for a stored OCR
+// job, the inverse of ValidatedOracleSpecToml, via
+// EthRequestEventFromJobSpecV2, so an exported bundle can be fed straight
+// back into Import.
+func MarshalOCRJobSpecToml(jobSpec models.JobSpecV2) (string, error) {
+	spec, err := EthRequestEventFromJobSpecV2(jobSpec)
+	if err != nil {
+		return "", err
+	}
+
+	observationSource, err := marshalObservationSource(spec.Pipeline)
+	if err != nil {
+		return "", err
+	}
+
+	header := ocrJobSpecTomlHeader{
+		Type:                        spec.Type,
+		SchemaVersion:               spec.SchemaVersion,
+		Name:                        spec.Name,
+		MaxTaskDuration:             spec.MaxTaskDuration,
+		OffchainReportingOracleSpec: spec.OffchainReportingOracleSpec,
+	}
+
+	buf := new(bytes.Buffer)
+	if err := toml.NewEncoder(buf).Encode(header); err != nil {
+		return "", errors.Wrap(err, "failed to marshal OCR job spec")
+	}
+	fmt.Fprintf(buf, "observationSource = '''\n%s'''\n", observationSource)
+
+	return buf.String(), nil
+}
+
+// fmJobSpecTomlHeader is the Flux Monitor equivalent of
+// ocrJobSpecTomlHeader.
+type fmJobSpecTomlHeader struct {
+	Type          string      `toml:"type"`
+	SchemaVersion uint32      `toml:"schemaVersion"`
+	Name          null.String `toml:"name"`
+
+	models.FluxMonitorSpec
+}
+
+// MarshalFMJobSpecToml is the Flux Monitor equivalent of
+// MarshalOCRJobSpecToml.
+func MarshalFMJobSpecToml(jobSpec models.JobSpecV2) (string, error) {
+	spec, err := FluxMonitorFromJobSpecV2(jobSpec)
+	if err != nil {
+		return "", err
+	}
+
+	observationSource, err := marshalObservationSource(spec.Pipeline)
+	if err != nil {
+		return "", err
+	}
+
+	header := fmJobSpecTomlHeader{
+		Type:            spec.Type,
+		SchemaVersion:   spec.SchemaVersion,
+		Name:            spec.Name,
+		FluxMonitorSpec: spec.FluxMonitorSpec,
+	}
+
+	buf := new(bytes.Buffer)
+	if err := toml.NewEncoder(buf).Encode(header); err != nil {
+		return "", errors.Wrap(err, "failed to marshal Flux Monitor job spec")
+	}
+	fmt.Fprintf(buf, "observationSource = '''\n%s'''\n", observationSource)
+
+	return buf.String(), nil
+}
@@ -0,0 +1,44 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gonum.org/v1/gonum/graph/encoding/dot"
+
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+// TestMarshalObservationSource_RoundTrip confirms a TaskDAG marshaled by
+// marshalObservationSource can be read straight back into an equivalent
+// TaskDAG by dot.Unmarshal, the same call ValidatedOracleSpecToml uses on
+// an observationSource field — this is the round trip Export/Import
+// depend on.
+func TestMarshalObservationSource_RoundTrip(t *testing.T) {
+	var original pipeline.TaskDAG
+	require.NoError(t, dot.Unmarshal([]byte(`
+		digraph {
+			fetch [type=bridge];
+			parse [type=jsonparse];
+			fetch -> parse;
+		}
+	`), &original))
+
+	dotText, err := marshalObservationSource(original)
+	require.NoError(t, err)
+
+	var roundTripped pipeline.TaskDAG
+	require.NoError(t, dot.Unmarshal([]byte(dotText), &roundTripped))
+
+	wantTasks, err := original.TasksInDependencyOrder()
+	require.NoError(t, err)
+	gotTasks, err := roundTripped.TasksInDependencyOrder()
+	require.NoError(t, err)
+
+	require.Len(t, gotTasks, len(wantTasks))
+	for i, want := range wantTasks {
+		assert.Equal(t, want.DotID(), gotTasks[i].DotID())
+		assert.Equal(t, want.Type(), gotTasks[i].Type())
+	}
+}
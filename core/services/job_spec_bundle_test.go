@@ -0,0 +1,28 @@
+package services_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/services"
+)
+
+func TestSniffJobSpecType(t *testing.T) {
+	t.Run("returns the type field", func(t *testing.T) {
+		jobType, err := services.SniffJobSpecType(`type = "fluxmonitor"`)
+		require.NoError(t, err)
+		assert.Equal(t, "fluxmonitor", jobType)
+	})
+
+	t.Run("rejects a spec missing its type", func(t *testing.T) {
+		_, err := services.SniffJobSpecType(`schemaVersion = 1`)
+		assert.Error(t, err)
+	})
+
+	t.Run("rejects malformed toml", func(t *testing.T) {
+		_, err := services.SniffJobSpecType(`not valid toml {{{`)
+		assert.Error(t, err)
+	})
+}
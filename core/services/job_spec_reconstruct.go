@@ -0,0 +1,45 @@
+package services
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+// EthRequestEventFromJobSpecV2 reconstructs the in-memory EthRequestEvent
+// for a stored OCR job, rebuilding its pipeline from the job's stored task
+// specs via pipeline.NewTaskDAGFromTaskSpecs. Both MarshalOCRJobSpecToml
+// and Update's rollback path need this same reconstruction.
+func EthRequestEventFromJobSpecV2(jobSpec models.JobSpecV2) (EthRequestEvent, error) {
+	dag, err := pipeline.NewTaskDAGFromTaskSpecs(jobSpec.PipelineSpec.TaskSpecs)
+	if err != nil {
+		return EthRequestEvent{}, errors.Wrap(err, "could not reconstruct observationSource")
+	}
+
+	return EthRequestEvent{
+		Type:                        jobSpec.Type,
+		SchemaVersion:               jobSpec.SchemaVersion,
+		Name:                        jobSpec.Name,
+		MaxTaskDuration:             jobSpec.MaxTaskDuration,
+		OffchainReportingOracleSpec: jobSpec.OffchainReportingOracleSpec,
+		Pipeline:                    dag,
+	}, nil
+}
+
+// FluxMonitorFromJobSpecV2 is the Flux Monitor equivalent of
+// EthRequestEventFromJobSpecV2.
+func FluxMonitorFromJobSpecV2(jobSpec models.JobSpecV2) (FluxMonitor, error) {
+	dag, err := pipeline.NewTaskDAGFromTaskSpecs(jobSpec.PipelineSpec.TaskSpecs)
+	if err != nil {
+		return FluxMonitor{}, errors.Wrap(err, "could not reconstruct observationSource")
+	}
+
+	return FluxMonitor{
+		Type:            jobSpec.Type,
+		SchemaVersion:   jobSpec.SchemaVersion,
+		Name:            jobSpec.Name,
+		FluxMonitorSpec: jobSpec.FluxMonitorSpec,
+		Pipeline:        dag,
+	}, nil
+}
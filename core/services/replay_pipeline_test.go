@@ -0,0 +1,80 @@
+package services_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline/mocks"
+)
+
+type fakeHeadReader struct{}
+
+func (fakeHeadReader) HeaderByNumber(_ context.Context, number *big.Int) (*gethtypes.Header, error) {
+	return &gethtypes.Header{Number: number}, nil
+}
+
+func TestReplayPipeline(t *testing.T) {
+	t.Run("rejects toBlock before fromBlock", func(t *testing.T) {
+		_, err := services.ReplayPipeline(context.Background(), fakeHeadReader{}, nil, 10, 5)
+		assert.Error(t, err)
+	})
+
+	t.Run("runs every task once per block in the range", func(t *testing.T) {
+		task := new(mocks.Task)
+		task.On("Run", mock.Anything, mock.Anything).Return(pipeline.Result{Value: "ok"})
+		task.On("OutputTask").Return(nil)
+
+		runs, err := services.ReplayPipeline(context.Background(), fakeHeadReader{}, []pipeline.Task{task}, 5, 7)
+
+		require.NoError(t, err)
+		assert.Len(t, runs, 3)
+		for _, run := range runs {
+			assert.True(t, run.Replayed)
+		}
+		task.AssertNumberOfCalls(t, "Run", 3)
+	})
+
+	t.Run("feeds each task its real parent's result in a linear chain", func(t *testing.T) {
+		first := new(mocks.Task)
+		second := new(mocks.Task)
+
+		first.On("DotID").Return("first")
+		first.On("OutputTask").Return(second)
+		first.On("Run", mock.Anything, []pipeline.Result{{Value: big.NewInt(5)}}).Return(pipeline.Result{Value: "first-result"})
+
+		second.On("DotID").Return("second")
+		second.On("OutputTask").Return(nil)
+		second.On("Run", mock.Anything, []pipeline.Result{{Value: "first-result"}}).Return(pipeline.Result{Value: "second-result"})
+
+		runs, err := services.ReplayPipeline(context.Background(), fakeHeadReader{}, []pipeline.Task{first, second}, 5, 5)
+
+		require.NoError(t, err)
+		require.Len(t, runs, 1)
+		assert.Equal(t, pipeline.Result{Value: "second-result"}, runs[0].Output)
+		second.AssertCalled(t, "Run", mock.Anything, []pipeline.Result{{Value: "first-result"}})
+	})
+
+	t.Run("rejects a task with more than one parent", func(t *testing.T) {
+		merge := new(mocks.Task)
+		merge.On("DotID").Return("merge")
+		merge.On("OutputTask").Return(nil)
+
+		parentA := new(mocks.Task)
+		parentA.On("OutputTask").Return(merge)
+
+		parentB := new(mocks.Task)
+		parentB.On("OutputTask").Return(merge)
+
+		_, err := services.ReplayPipeline(context.Background(), fakeHeadReader{}, []pipeline.Task{parentA, parentB, merge}, 5, 5)
+
+		assert.Error(t, err)
+	})
+}
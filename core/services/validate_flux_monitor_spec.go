@@ -0,0 +1,35 @@
+package services
+
+import (
+	"github.com/BurntSushi/toml"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+)
+
+// ValidatedFluxMonitorSpecToml decodes and validates a Flux Monitor V2 job
+// spec's TOML, analogous to ValidatedOracleSpecToml for OCR. It does not
+// touch the database or start the job.
+func ValidatedFluxMonitorSpecToml(tomlString string) (FluxMonitor, error) {
+	var spec FluxMonitor
+	if err := toml.Unmarshal([]byte(tomlString), &spec); err != nil {
+		return spec, errors.Wrap(err, "toml error on load")
+	}
+
+	if spec.Type != "fluxmonitor" {
+		return spec, errors.Errorf("unsupported type %s", spec.Type)
+	}
+	if (spec.ContractAddress == common.Address{}) {
+		return spec, errors.New("no contract address provided")
+	}
+	if spec.Threshold <= 0 {
+		return spec, errors.New("threshold must be greater than 0")
+	}
+	if spec.MinPayment != nil && spec.MinPayment.IsNegative() {
+		return spec, errors.New("minPayment must not be negative")
+	}
+	if _, err := spec.Pipeline.TasksInDependencyOrder(); err != nil {
+		return spec, errors.Wrap(err, "invalid observationSource")
+	}
+
+	return spec, nil
+}
@@ -0,0 +1,47 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatedFluxMonitorSpecToml(t *testing.T) {
+	t.Run("rejects wrong type", func(t *testing.T) {
+		_, err := ValidatedFluxMonitorSpecToml(`
+			type = "offchainreporting"
+			schemaVersion = 1
+		`)
+		assert.Error(t, err)
+	})
+
+	t.Run("rejects missing contract address", func(t *testing.T) {
+		_, err := ValidatedFluxMonitorSpecToml(`
+			type = "fluxmonitor"
+			schemaVersion = 1
+			threshold = 0.5
+		`)
+		assert.Error(t, err)
+	})
+
+	t.Run("rejects non-positive threshold", func(t *testing.T) {
+		_, err := ValidatedFluxMonitorSpecToml(`
+			type = "fluxmonitor"
+			schemaVersion = 1
+			contractAddress = "0x1234567890123456789012345678901234567890"
+			threshold = 0
+		`)
+		assert.Error(t, err)
+	})
+
+	t.Run("rejects negative minPayment", func(t *testing.T) {
+		_, err := ValidatedFluxMonitorSpecToml(`
+			type = "fluxmonitor"
+			schemaVersion = 1
+			contractAddress = "0x1234567890123456789012345678901234567890"
+			threshold = 0.5
+			minPayment = "-1"
+		`)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,66 @@
+package services
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/store"
+)
+
+// ValidationReport is the result of dry-running a job spec. Errors are
+// keyed by the DotID of the pipeline task that produced them; a missing key
+// bundle, peer ID or transmitter address, which belongs to no particular
+// task, is keyed by the empty string. A spec that fails to parse or
+// validate produces no report entry: ValidateOCRJobSpec returns that as
+// its error instead.
+type ValidationReport struct {
+	Errors map[string][]string `json:"errors,omitempty"`
+}
+
+// HasErrors reports whether the report contains any task-level or
+// spec-level errors.
+func (r ValidationReport) HasErrors() bool {
+	return len(r.Errors) > 0
+}
+
+func (r *ValidationReport) addError(dotID string, err error) {
+	if r.Errors == nil {
+		r.Errors = make(map[string][]string)
+	}
+	r.Errors[dotID] = append(r.Errors[dotID], err.Error())
+}
+
+// ValidateOCRJobSpec parses the given OCR job spec TOML via
+// ValidatedOracleSpecToml and then dry-runs it: it walks the resulting
+// pipeline.TaskDAG in dependency order, confirming that every bridge task's
+// bridge resolves via FindBridge and that the spec's key bundle, peer ID,
+// and transmitter address all exist — without writing anything to the
+// database or calling AddJobV2. Create calls this directly so the
+// validate-only endpoint and the creation path can never drift apart.
+func ValidateOCRJobSpec(s *store.Store, toml string) (EthRequestEvent, ValidationReport, error) {
+	var report ValidationReport
+
+	spec, err := ValidatedOracleSpecToml(toml)
+	if err != nil {
+		return spec, report, err
+	}
+
+	tasks, err := spec.Pipeline.TasksInDependencyOrder()
+	if err != nil {
+		return spec, report, errors.Wrap(err, "could not order pipeline tasks")
+	}
+	for _, task := range tasks {
+		if bridgeTask, ok := task.(*pipeline.BridgeTask); ok {
+			if _, err := s.ORM.FindBridge(bridgeTask.Name); err != nil {
+				report.addError(task.DotID(), errors.Wrapf(err, "bridge %q does not exist", bridgeTask.Name))
+			}
+		}
+	}
+
+	if err := job.ValidateKeyStoreDependencies(s, spec.OffchainReportingOracleSpec); err != nil {
+		report.addError("", err)
+	}
+
+	return spec, report, nil
+}
@@ -0,0 +1,28 @@
+package migration1595339100
+
+import (
+	"github.com/jinzhu/gorm"
+)
+
+// Migrate adds the flux_monitor_specs table backing Flux Monitor V2 job
+// specs and links it to jobs, mirroring offchainreporting_oracle_specs for
+// OCR jobs.
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+		CREATE TABLE flux_monitor_specs (
+			id SERIAL PRIMARY KEY,
+			contract_address bytea NOT NULL,
+			threshold NUMERIC NOT NULL,
+			absolute_threshold NUMERIC NOT NULL,
+			poll_timer_period BIGINT NOT NULL,
+			poll_timer_disabled BOOLEAN NOT NULL DEFAULT FALSE,
+			idle_timer_period BIGINT NOT NULL,
+			idle_timer_disabled BOOLEAN NOT NULL DEFAULT FALSE,
+			min_payment NUMERIC(78, 0),
+			created_at TIMESTAMPTZ NOT NULL,
+			updated_at TIMESTAMPTZ NOT NULL
+		);
+
+		ALTER TABLE jobs ADD COLUMN flux_monitor_spec_id INTEGER REFERENCES flux_monitor_specs(id);
+	`).Error
+}
@@ -0,0 +1,30 @@
+package models
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink/core/store/assets"
+)
+
+// FluxMonitorSpec is the DB representation of a Flux Monitor V2 job spec,
+// analogous to OffchainReportingOracleSpec for OCR jobs.
+type FluxMonitorSpec struct {
+	ID                int32          `toml:"-"`
+	ContractAddress   common.Address `toml:"contractAddress"`
+	Threshold         float32        `toml:"threshold"`
+	AbsoluteThreshold float32        `toml:"absoluteThreshold"`
+	PollTimerPeriod   Interval       `toml:"pollTimerPeriod"`
+	PollTimerDisabled bool           `toml:"pollTimerDisabled"`
+	IdleTimerPeriod   Interval       `toml:"idleTimerPeriod"`
+	IdleTimerDisabled bool           `toml:"idleTimerDisabled"`
+	// MinPayment is the minimum LINK payment the job will accept, overriding
+	// the node's global minimum payment when set. A nil value means the
+	// global minimum applies.
+	MinPayment *assets.Link `toml:"minPayment"`
+}
+
+// CreateFMJobSpecRequest represents a schema for the Create Flux Monitor
+// Job Specs request, mirroring CreateOCRJobSpecRequest.
+type CreateFMJobSpecRequest struct {
+	TOML string `json:"toml"`
+}
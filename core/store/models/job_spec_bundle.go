@@ -0,0 +1,16 @@
+package models
+
+// JobSpecBundle is the TOML document produced by Export and consumed by
+// Import: a flat list of complete job spec TOML documents, one per job,
+// regardless of job type.
+type JobSpecBundle struct {
+	Specs []string `toml:"specs"`
+}
+
+// JobSpecImportResult reports the outcome of importing a single spec from
+// a JobSpecBundle, indexed to match its position in Specs.
+type JobSpecImportResult struct {
+	Index int    `json:"index"`
+	JobID int32  `json:"jobId,omitempty"`
+	Error string `json:"error,omitempty"`
+}
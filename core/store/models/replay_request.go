@@ -0,0 +1,9 @@
+package models
+
+// ReplayRequest represents the schema for the pipeline replay request: the
+// block range a job's observation source should be re-run over. ToBlock
+// defaults to the highest head the node has stored when omitted.
+type ReplayRequest struct {
+	FromBlock int64  `json:"fromBlock"`
+	ToBlock   *int64 `json:"toBlock"`
+}
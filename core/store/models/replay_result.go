@@ -0,0 +1,9 @@
+package models
+
+// ReplayResult is the response to a Replay request: the IDs of the
+// pipeline_task_runs rows Replay persisted, one per block in the
+// requested range, so a caller can poll for each run's result instead of
+// needing them all back in the same response.
+type ReplayResult struct {
+	RunIDs []int32 `json:"runIds"`
+}
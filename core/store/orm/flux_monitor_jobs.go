@@ -0,0 +1,35 @@
+package orm
+
+import (
+	"github.com/jinzhu/gorm"
+
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+// FluxMonitorJobs returns every Flux Monitor V2 job spec, joined with its
+// flux_monitor_specs row and pipeline task specs, mirroring
+// OffChainReportingJobs for OCR.
+func (orm *ORM) FluxMonitorJobs() ([]models.JobSpecV2, error) {
+	var jobs []models.JobSpecV2
+	err := orm.DB.
+		Preload("FluxMonitorSpec").
+		Preload("PipelineSpec.TaskSpecs").
+		Where("type = ?", "fluxmonitor").
+		Find(&jobs).Error
+	return jobs, err
+}
+
+// FindFluxMonitorJob returns the Flux Monitor V2 job spec with the given
+// ID, or ErrorNotFound if it doesn't exist.
+func (orm *ORM) FindFluxMonitorJob(id int32) (models.JobSpecV2, error) {
+	var job models.JobSpecV2
+	err := orm.DB.
+		Preload("FluxMonitorSpec").
+		Preload("PipelineSpec.TaskSpecs").
+		Where("type = ?", "fluxmonitor").
+		First(&job, "id = ?", id).Error
+	if err == gorm.ErrRecordNotFound {
+		return job, ErrorNotFound
+	}
+	return job, err
+}
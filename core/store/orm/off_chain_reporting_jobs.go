@@ -0,0 +1,46 @@
+package orm
+
+import (
+	"github.com/jinzhu/gorm"
+
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+// OffChainReportingJobs returns every OCR V2 job spec, joined with its
+// offchainreporting_oracle_specs row and pipeline task specs.
+func (orm *ORM) OffChainReportingJobs() ([]models.JobSpecV2, error) {
+	var jobs []models.JobSpecV2
+	err := orm.DB.
+		Preload("OffchainReportingOracleSpec").
+		Preload("PipelineSpec.TaskSpecs").
+		Where("type = ?", "offchainreporting").
+		Find(&jobs).Error
+	return jobs, err
+}
+
+// FindOffChainReportingJob returns the OCR V2 job spec with the given ID,
+// or ErrorNotFound if it doesn't exist.
+func (orm *ORM) FindOffChainReportingJob(id int32) (models.JobSpecV2, error) {
+	var job models.JobSpecV2
+	err := orm.DB.
+		Preload("OffchainReportingOracleSpec").
+		Preload("PipelineSpec.TaskSpecs").
+		Where("type = ?", "offchainreporting").
+		First(&job, "id = ?", id).Error
+	if err == gorm.ErrRecordNotFound {
+		return job, ErrorNotFound
+	}
+	return job, err
+}
+
+// LastHead returns the chain head with the highest block number the node
+// has seen, used as the default upper bound for a Replay request that
+// omits toBlock.
+func (orm *ORM) LastHead() (models.Head, error) {
+	var head models.Head
+	err := orm.DB.Order("number DESC").First(&head).Error
+	if err == gorm.ErrRecordNotFound {
+		return head, ErrorNotFound
+	}
+	return head, err
+}
@@ -0,0 +1,26 @@
+package orm
+
+import (
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+// PersistTaskRuns saves the in-memory results of a Replay as real
+// pipeline_task_runs rows against pipelineSpecID, so a caller can poll for
+// them afterward instead of only getting back whatever a single HTTP
+// response held. It returns the new rows' IDs in the same order as runs.
+func (orm *ORM) PersistTaskRuns(pipelineSpecID int32, runs []pipeline.TaskRun) ([]int32, error) {
+	ids := make([]int32, len(runs))
+	for i, run := range runs {
+		row := models.TaskRun{
+			PipelineSpecID: pipelineSpecID,
+			Output:         run.Output,
+			Replayed:       run.Replayed,
+		}
+		if err := orm.DB.Create(&row).Error; err != nil {
+			return ids[:i], err
+		}
+		ids[i] = row.ID
+	}
+	return ids, nil
+}
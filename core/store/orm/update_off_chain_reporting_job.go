@@ -0,0 +1,60 @@
+package orm
+
+import (
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+// UpdateOffChainReportingJob atomically overwrites the OCR job with the
+// given ID with newSpec: its MaxTaskDuration, its full
+// OffchainReportingOracleSpec (contract address, key bundle, peer ID,
+// transmitter address and timers included, not just MaxTaskDuration), and
+// its pipeline task specs, re-inserted in dependency order. It returns the
+// updated row. newSpec's pipeline is ordered before anything is written;
+// if that fails, the existing row is never touched. If any step of the
+// write itself fails, the whole transaction is rolled back, so a
+// validation or write failure always leaves the previous version's row
+// untouched. This only swaps the stored spec — restarting the running job
+// under the new spec is the caller's responsibility.
+func (orm *ORM) UpdateOffChainReportingJob(id int32, newSpec services.EthRequestEvent) (models.JobSpecV2, error) {
+	var updated models.JobSpecV2
+
+	tasks, err := newSpec.Pipeline.TasksInDependencyOrder()
+	if err != nil {
+		return updated, errors.Wrap(err, "could not order pipeline tasks")
+	}
+
+	err = orm.DB.Transaction(func(tx *gorm.DB) error {
+		existing := models.JobSpecV2{}
+		if err := tx.Preload("PipelineSpec").First(&existing, "id = ?", id).Error; err != nil {
+			return err
+		}
+
+		existing.MaxTaskDuration = newSpec.MaxTaskDuration
+		existing.OffchainReportingOracleSpec = newSpec.OffchainReportingOracleSpec
+		if err := tx.Save(&existing).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Where("pipeline_spec_id = ?", existing.PipelineSpec.ID).Delete(&models.TaskSpec{}).Error; err != nil {
+			return err
+		}
+		for _, task := range tasks {
+			taskSpec := models.TaskSpec{
+				PipelineSpecID: existing.PipelineSpec.ID,
+				DotID:          task.DotID(),
+				Type:           string(task.Type()),
+			}
+			if err := tx.Create(&taskSpec).Error; err != nil {
+				return err
+			}
+		}
+
+		return tx.Preload("PipelineSpec.TaskSpecs").First(&updated, "id = ?", id).Error
+	})
+
+	return updated, err
+}
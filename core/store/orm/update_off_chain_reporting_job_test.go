@@ -0,0 +1,38 @@
+package orm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gonum.org/v1/gonum/graph/encoding/dot"
+
+	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+// TestORM_UpdateOffChainReportingJob_RollsBackOnInvalidPipeline confirms
+// that an invalid new pipeline is rejected before any database access, so
+// a failed Update can never disturb the job it was trying to replace. The
+// ORM here has a nil *gorm.DB: if the implementation touched it before
+// validating the pipeline, this test would panic instead of returning an
+// error.
+func TestORM_UpdateOffChainReportingJob_RollsBackOnInvalidPipeline(t *testing.T) {
+	var dag pipeline.TaskDAG
+	require.NoError(t, dot.Unmarshal([]byte(`
+		digraph {
+			a [type=bridge];
+			b [type=bridge];
+			a -> b;
+			b -> a;
+		}
+	`), &dag))
+
+	newSpec := services.EthRequestEvent{Type: "offchainreporting", Pipeline: dag}
+	testOrm := &ORM{}
+
+	updated, err := testOrm.UpdateOffChainReportingJob(1, newSpec)
+
+	assert.Error(t, err)
+	assert.Zero(t, updated)
+}
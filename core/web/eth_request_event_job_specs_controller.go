@@ -9,6 +9,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/offchainreporting"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/store/orm"
 )
@@ -65,11 +66,15 @@ func (erejsc *EthRequestEventJobSpecsController) Create(c *gin.Context) {
 		jsonAPIError(c, http.StatusUnprocessableEntity, err)
 		return
 	}
-	jobSpec, err := services.ValidatedOracleSpecToml(request.TOML)
+	jobSpec, report, err := services.ValidateOCRJobSpec(erejsc.App.GetStore(), request.TOML)
 	if err != nil {
 		jsonAPIError(c, http.StatusBadRequest, err)
 		return
 	}
+	if report.HasErrors() {
+		jsonAPIError(c, http.StatusBadRequest, errors.Errorf("invalid job spec: %+v", report.Errors))
+		return
+	}
 	config := erejsc.App.GetStore().Config
 	if jobSpec.JobType() == offchainreporting.JobType && !config.Dev() && !config.FeatureOffchainReporting() {
 		jsonAPIError(c, http.StatusNotImplemented, errors.New("The Offchain Reporting feature is disabled by configuration"))
@@ -95,6 +100,167 @@ func (erejsc *EthRequestEventJobSpecsController) Create(c *gin.Context) {
 	jsonAPIResponse(c, job, "offChainReportingJobSpec")
 }
 
+// Validate dry-runs an OCR job spec TOML without persisting or starting it.
+// Example:
+// "POST <application>/ethrequestevent/specs/validate"
+func (erejsc *EthRequestEventJobSpecsController) Validate(c *gin.Context) {
+	request := models.CreateOCRJobSpecRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	_, report, err := services.ValidateOCRJobSpec(erejsc.App.GetStore(), request.TOML)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	jsonAPIResponse(c, report, "ocrJobSpecValidationReport")
+}
+
+// Update validates a new TOML payload for an existing OCR job spec,
+// atomically overwrites its stored spec and pipeline task specs in
+// dependency order under the same job ID, then restarts the running job
+// under the new spec so it never keeps running against stale contract or
+// key config. If the restart fails, the stored row is reverted back to
+// the spec that was actually running and the request reports an error,
+// rather than leaving the node serving a spec nothing is executing under.
+// JobType and schema version cannot change via Update — delete and
+// recreate the job instead.
+// Example:
+// "PATCH <application>/ethrequestevent/specs/:ID"
+func (erejsc *EthRequestEventJobSpecsController) Update(c *gin.Context) {
+	jobSpec := models.JobSpecV2{}
+	if err := jobSpec.SetID(c.Param("ID")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	existing, err := erejsc.App.GetStore().ORM.FindOffChainReportingJob(jobSpec.ID)
+	if errors.Cause(err) == orm.ErrorNotFound {
+		jsonAPIError(c, http.StatusNotFound, errors.New("OCR job spec not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	request := models.CreateOCRJobSpecRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	newSpec, report, err := services.ValidateOCRJobSpec(erejsc.App.GetStore(), request.TOML)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+	if report.HasErrors() {
+		jsonAPIError(c, http.StatusBadRequest, errors.Errorf("invalid job spec: %+v", report.Errors))
+		return
+	}
+	if !specVersionsMatch(existing, newSpec) {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("cannot change job type or schema version via Update"))
+		return
+	}
+
+	updated, err := erejsc.App.GetStore().ORM.UpdateOffChainReportingJob(existing.ID, newSpec)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	if err := erejsc.App.RestartJobV2(c.Request.Context(), existing.ID); err != nil {
+		// The running service is now out of sync with the stored spec it
+		// thinks it's running under, so revert the row to what was running
+		// before this Update and report the failure rather than leaving
+		// the node serving a spec nothing is actually executing.
+		rollbackSpec, reconstructErr := services.EthRequestEventFromJobSpecV2(existing)
+		if reconstructErr != nil {
+			jsonAPIError(c, http.StatusInternalServerError, errors.Wrap(err, "restart failed, and could not reconstruct prior spec to roll back"))
+			return
+		}
+		if _, rollbackErr := erejsc.App.GetStore().ORM.UpdateOffChainReportingJob(existing.ID, rollbackSpec); rollbackErr != nil {
+			jsonAPIError(c, http.StatusInternalServerError, errors.Wrap(err, "restart failed, and rollback of the stored spec also failed"))
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, errors.Wrap(err, "restarting job under new spec"))
+		return
+	}
+
+	jsonAPIResponse(c, updated, "offChainReportingJobSpec")
+}
+
+// Replay re-runs an existing OCR job's pipeline once for each block in a
+// range of historical blocks via services.ReplayPipeline, which seeds the
+// pipeline with that block's number, persists each block's run flagged as
+// replayed, and returns their IDs so a caller
+// can poll for the results rather than needing them all back inline.
+// Example:
+// "POST <application>/ethrequestevent/specs/:ID/replay"
+func (erejsc *EthRequestEventJobSpecsController) Replay(c *gin.Context) {
+	jobSpec := models.JobSpecV2{}
+	if err := jobSpec.SetID(c.Param("ID")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	existing, err := erejsc.App.GetStore().ORM.FindOffChainReportingJob(jobSpec.ID)
+	if errors.Cause(err) == orm.ErrorNotFound {
+		jsonAPIError(c, http.StatusNotFound, errors.New("OCR job spec not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	request := models.ReplayRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	toBlock := request.ToBlock
+	if toBlock == nil {
+		head, err := erejsc.App.GetStore().ORM.LastHead()
+		if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+		latest := head.Number
+		toBlock = &latest
+	}
+
+	dag, err := pipeline.NewTaskDAGFromTaskSpecs(existing.PipelineSpec.TaskSpecs)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	tasks, err := dag.TasksInDependencyOrder()
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	runs, err := services.ReplayPipeline(c.Request.Context(), erejsc.App.GetStore().EthClient, tasks, request.FromBlock, *toBlock)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	runIDs, err := erejsc.App.GetStore().ORM.PersistTaskRuns(existing.PipelineSpec.ID, runs)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, errors.Wrap(err, "persisting replayed task runs"))
+		return
+	}
+
+	jsonAPIResponse(c, models.ReplayResult{RunIDs: runIDs}, "pipelineRun")
+}
+
 // Delete soft deletes an OCR job spec.
 // Example:
 // "DELETE <application>/ethrequestevent/specs/:ID"
@@ -118,3 +284,11 @@ func (erejsc *EthRequestEventJobSpecsController) Delete(c *gin.Context) {
 
 	jsonAPIResponseWithStatus(c, nil, "offChainReportingJobSpec", http.StatusNoContent)
 }
+
+// specVersionsMatch reports whether newSpec could replace existing in
+// place: Update may change the pipeline DAG and its tasks, but never the
+// job's type or schema version, since those determine how the rest of the
+// node interprets the stored spec.
+func specVersionsMatch(existing models.JobSpecV2, newSpec services.EthRequestEvent) bool {
+	return string(newSpec.JobType()) == existing.Type && newSpec.SchemaVersion == existing.SchemaVersion
+}
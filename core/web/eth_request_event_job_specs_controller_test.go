@@ -0,0 +1,29 @@
+package web
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+func TestSpecVersionsMatch(t *testing.T) {
+	existing := models.JobSpecV2{Type: "offchainreporting", SchemaVersion: 1}
+
+	t.Run("same type and schema version", func(t *testing.T) {
+		newSpec := services.EthRequestEvent{Type: "offchainreporting", SchemaVersion: 1}
+		assert.True(t, specVersionsMatch(existing, newSpec))
+	})
+
+	t.Run("different job type is rejected", func(t *testing.T) {
+		newSpec := services.EthRequestEvent{Type: "fluxmonitor", SchemaVersion: 1}
+		assert.False(t, specVersionsMatch(existing, newSpec))
+	})
+
+	t.Run("different schema version is rejected", func(t *testing.T) {
+		newSpec := services.EthRequestEvent{Type: "offchainreporting", SchemaVersion: 2}
+		assert.False(t, specVersionsMatch(existing, newSpec))
+	})
+}
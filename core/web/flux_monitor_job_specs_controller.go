@@ -0,0 +1,111 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/store/orm"
+)
+
+// FluxMonitorJobSpecsController manages Flux Monitor V2 job spec requests,
+// mirroring EthRequestEventJobSpecsController for OCR jobs.
+type FluxMonitorJobSpecsController struct {
+	App chainlink.Application
+}
+
+// Index lists all Flux Monitor job specs.
+// Example:
+// "GET <application>/fluxmonitor/specs"
+func (fmjsc *FluxMonitorJobSpecsController) Index(c *gin.Context) {
+	jobs, err := fmjsc.App.GetStore().ORM.FluxMonitorJobs()
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, jobs, "fluxMonitorJobSpec")
+}
+
+// Show returns the details of a Flux Monitor job spec.
+// Example:
+// "GET <application>/fluxmonitor/specs/:ID"
+func (fmjsc *FluxMonitorJobSpecsController) Show(c *gin.Context) {
+	jobSpec := models.JobSpecV2{}
+	err := jobSpec.SetID(c.Param("ID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	jobSpec, err = fmjsc.App.GetStore().ORM.FindFluxMonitorJob(jobSpec.ID)
+	if errors.Cause(err) == orm.ErrorNotFound {
+		jsonAPIError(c, http.StatusNotFound, errors.New("Flux Monitor job spec not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, jobSpec, "fluxMonitorJobSpec")
+}
+
+// Create validates, saves and starts a new Flux Monitor job spec.
+// Example:
+// "POST <application>/fluxmonitor/specs"
+func (fmjsc *FluxMonitorJobSpecsController) Create(c *gin.Context) {
+	request := models.CreateFMJobSpecRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	jobSpec, err := services.ValidatedFluxMonitorSpecToml(request.TOML)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	jobID, err := fmjsc.App.AddJobV2(c.Request.Context(), jobSpec)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	job, err := fmjsc.App.GetStore().ORM.FindFluxMonitorJob(jobID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, job, "fluxMonitorJobSpec")
+}
+
+// Delete soft deletes a Flux Monitor job spec.
+// Example:
+// "DELETE <application>/fluxmonitor/specs/:ID"
+func (fmjsc *FluxMonitorJobSpecsController) Delete(c *gin.Context) {
+	jobSpec := models.JobSpecV2{}
+	err := jobSpec.SetID(c.Param("ID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	err = fmjsc.App.DeleteJobV2(c.Request.Context(), jobSpec.ID)
+	if errors.Cause(err) == orm.ErrorNotFound {
+		jsonAPIError(c, http.StatusNotFound, errors.New("JobSpec not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(c, nil, "fluxMonitorJobSpec", http.StatusNoContent)
+}
@@ -0,0 +1,170 @@
+package web
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/BurntSushi/toml"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+// JobSpecsBulkController handles bulk export and import of every V2 job
+// spec on the node.
+type JobSpecsBulkController struct {
+	App chainlink.Application
+}
+
+// Export streams every job spec back out as a single TOML bundle that
+// Import can read back in.
+// Example:
+// "GET <application>/v2/jobs/export"
+func (jsbc *JobSpecsBulkController) Export(c *gin.Context) {
+	store := jsbc.App.GetStore()
+
+	ocrJobs, err := store.ORM.OffChainReportingJobs()
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	fmJobs, err := store.ORM.FluxMonitorJobs()
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	bundle := models.JobSpecBundle{}
+	for _, jobSpec := range ocrJobs {
+		specTOML, err := services.MarshalOCRJobSpecToml(jobSpec)
+		if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+		bundle.Specs = append(bundle.Specs, specTOML)
+	}
+	for _, jobSpec := range fmJobs {
+		specTOML, err := services.MarshalFMJobSpecToml(jobSpec)
+		if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+		bundle.Specs = append(bundle.Specs, specTOML)
+	}
+
+	buf := new(bytes.Buffer)
+	if err := toml.NewEncoder(buf).Encode(bundle); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.Data(http.StatusOK, "application/toml", buf.Bytes())
+}
+
+// Import validates every spec in a JobSpecBundle, sharing the same
+// validators as the validate-only endpoint, and only once every spec
+// passes does it call AddJobV2 for each one in turn. If one fails partway
+// through, the jobs already created by this import are deleted again on a
+// best-effort basis (there is no real cross-job transaction — AddJobV2 and
+// DeleteJobV2 each commit on their own). Pass ?dry-run=true to run
+// validation only, without creating any jobs.
+// Example:
+// "POST <application>/v2/jobs/import?dry-run=true"
+func (jsbc *JobSpecsBulkController) Import(c *gin.Context) {
+	body, err := ioutil.ReadAll(c.Request.Body)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	var bundle models.JobSpecBundle
+	if err := toml.Unmarshal(body, &bundle); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	dryRun := c.Query("dry-run") == "true"
+	store := jsbc.App.GetStore()
+
+	results := make([]models.JobSpecImportResult, len(bundle.Specs))
+	allValid := true
+	for i, specTOML := range bundle.Specs {
+		results[i] = models.JobSpecImportResult{Index: i}
+
+		jobType, err := services.SniffJobSpecType(specTOML)
+		if err != nil {
+			allValid = false
+			results[i].Error = err.Error()
+			continue
+		}
+
+		switch jobType {
+		case "offchainreporting":
+			_, report, err := services.ValidateOCRJobSpec(store, specTOML)
+			if err != nil {
+				allValid = false
+				results[i].Error = err.Error()
+			} else if report.HasErrors() {
+				allValid = false
+				results[i].Error = errors.Errorf("invalid job spec: %+v", report.Errors).Error()
+			}
+		case "fluxmonitor":
+			if _, err := services.ValidatedFluxMonitorSpecToml(specTOML); err != nil {
+				allValid = false
+				results[i].Error = err.Error()
+			}
+		default:
+			allValid = false
+			results[i].Error = errors.Errorf("unsupported job type %q", jobType).Error()
+		}
+	}
+
+	if !allValid || dryRun {
+		jsonAPIResponse(c, results, "jobSpecImportResult")
+		return
+	}
+
+	for i, specTOML := range bundle.Specs {
+		jobType, _ := services.SniffJobSpecType(specTOML)
+
+		var (
+			jobID int32
+			err   error
+		)
+		switch jobType {
+		case "offchainreporting":
+			var jobSpec services.EthRequestEvent
+			jobSpec, _, err = services.ValidateOCRJobSpec(store, specTOML)
+			if err == nil {
+				jobID, err = jsbc.App.AddJobV2(c.Request.Context(), jobSpec)
+			}
+		case "fluxmonitor":
+			var jobSpec services.FluxMonitor
+			jobSpec, err = services.ValidatedFluxMonitorSpecToml(specTOML)
+			if err == nil {
+				jobID, err = jsbc.App.AddJobV2(c.Request.Context(), jobSpec)
+			}
+		}
+		if err != nil {
+			// Roll back every job this import already created so a single
+			// bad spec can't leave the node with a half-imported bundle. A
+			// failed delete here can't be retried automatically — log it so
+			// the orphaned job is at least visible to an operator.
+			for _, created := range results[:i] {
+				if delErr := jsbc.App.DeleteJobV2(c.Request.Context(), created.JobID); delErr != nil {
+					logger.Errorf("rolling back import: could not delete job %d: %v", created.JobID, delErr)
+				}
+			}
+			jsonAPIError(c, http.StatusInternalServerError, errors.Wrapf(err, "importing spec %d", i))
+			return
+		}
+		results[i].JobID = jobID
+	}
+
+	jsonAPIResponse(c, results, "jobSpecImportResult")
+}